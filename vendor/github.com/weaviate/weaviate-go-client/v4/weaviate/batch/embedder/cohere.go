@@ -0,0 +1,69 @@
+package embedder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const cohereEmbedURL = "https://api.cohere.ai/v1/embed"
+
+// CohereEmbedder embeds texts through the Cohere embed API.
+type CohereEmbedder struct {
+	apiKey     string
+	model      string
+	inputType  string
+	httpClient *http.Client
+}
+
+// NewCohereEmbedder returns an Embedder backed by the given Cohere API key and model
+// (e.g. "embed-english-v3.0"). inputType is passed through as Cohere's input_type
+// (e.g. "search_document").
+func NewCohereEmbedder(apiKey string, model string, inputType string) *CohereEmbedder {
+	return &CohereEmbedder{apiKey: apiKey, model: model, inputType: inputType, httpClient: http.DefaultClient}
+}
+
+type cohereEmbedRequest struct {
+	Model     string   `json:"model"`
+	Texts     []string `json:"texts"`
+	InputType string   `json:"input_type,omitempty"`
+}
+
+type cohereEmbedResponse struct {
+	Embeddings [][]float32 `json:"embeddings"`
+}
+
+func (e *CohereEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(cohereEmbedRequest{Model: e.model, Texts: texts, InputType: e.inputType})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cohereEmbedURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+e.apiKey)
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cohere embed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cohere embed: unexpected status %d", resp.StatusCode)
+	}
+
+	var parsed cohereEmbedResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	if len(parsed.Embeddings) != len(texts) {
+		return nil, fmt.Errorf("cohere embed: expected %d embeddings, got %d", len(texts), len(parsed.Embeddings))
+	}
+	return parsed.Embeddings, nil
+}
@@ -0,0 +1,228 @@
+// Package embedder generalizes the "embed one document, then write it to Weaviate" loop that
+// otherwise has to be hand-rolled and run serially. A Pipeline batches documents, embeds each
+// batch concurrently through one or more Embedder providers, and flushes the results into
+// Weaviate through the existing batch object writer.
+package embedder
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Embedder turns a batch of texts into their vector embeddings, in the same order.
+type Embedder interface {
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// Document is a single object to be embedded and written to Weaviate.
+type Document struct {
+	ID         string
+	ClassName  string
+	Properties models.PropertySchema
+	Text       string
+}
+
+// Result reports what happened to a single document after the pipeline ran.
+type Result struct {
+	Document Document
+	Err      error
+}
+
+// ObjectWriter is the batch object write path the pipeline flushes embedded documents through.
+// It mirrors the client's existing batch object writer: one error per object, aligned by index,
+// plus a top-level error for failures that prevented the whole batch from being sent.
+type ObjectWriter interface {
+	BatchCreate(ctx context.Context, objects []*models.Object) ([]error, error)
+}
+
+// TargetEmbedders maps a named target vector to the Embedder responsible for it, so a single
+// object can be indexed with multiple named vectors sourced from different providers
+// (e.g. a "text" target from Ollama and an "image" target from a different model).
+type TargetEmbedders map[string]Embedder
+
+// Pipeline streams documents through a bounded worker pool that batches embedding requests per
+// provider and flushes the embedded objects into Weaviate via the batch object writer.
+type Pipeline struct {
+	embedders   TargetEmbedders
+	writer      ObjectWriter
+	batchSize   int
+	concurrency int
+	maxRetries  int
+	backoff     func(attempt int) time.Duration
+}
+
+// Option configures a Pipeline
+type Option func(*Pipeline)
+
+// WithBatchSize sets how many documents are embedded and written together. Default 100.
+func WithBatchSize(batchSize int) Option {
+	return func(p *Pipeline) { p.batchSize = batchSize }
+}
+
+// WithConcurrency sets how many batches are in flight at once. Default 4.
+func WithConcurrency(concurrency int) Option {
+	return func(p *Pipeline) { p.concurrency = concurrency }
+}
+
+// WithMaxRetries sets how many times a failed embedding call is retried before the batch's
+// documents are reported as failed. Default 3.
+func WithMaxRetries(maxRetries int) Option {
+	return func(p *Pipeline) { p.maxRetries = maxRetries }
+}
+
+// WithBackoff overrides the delay between retries. Default is exponential backoff starting at
+// 200ms.
+func WithBackoff(backoff func(attempt int) time.Duration) Option {
+	return func(p *Pipeline) { p.backoff = backoff }
+}
+
+// NewPipeline constructs a Pipeline that writes through writer, embedding the named target
+// vector in embedders[target] for every document.
+func NewPipeline(writer ObjectWriter, embedders TargetEmbedders, opts ...Option) *Pipeline {
+	p := &Pipeline{
+		writer:      writer,
+		embedders:   embedders,
+		batchSize:   100,
+		concurrency: 4,
+		maxRetries:  3,
+		backoff:     defaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func defaultBackoff(attempt int) time.Duration {
+	return time.Duration(200*(1<<attempt)) * time.Millisecond
+}
+
+// Run embeds and writes documents, returning one Result per document in the input order. It
+// stops launching new batches as soon as ctx is cancelled, but still returns a Result - with
+// ctx.Err() - for every document that was not processed.
+func (p *Pipeline) Run(ctx context.Context, documents []Document) ([]Result, error) {
+	results := make([]Result, len(documents))
+	batches := p.chunk(documents)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, p.concurrency)
+
+	offset := 0
+	for _, batch := range batches {
+		batchOffset := offset
+		offset += len(batch)
+
+		if err := ctx.Err(); err != nil {
+			p.fillErr(results, batchOffset, len(batch), err)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			p.fillErr(results, batchOffset, len(batch), ctx.Err())
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(batch []Document, offset int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			p.runBatch(ctx, batch, results, offset)
+		}(batch, batchOffset)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+func (p *Pipeline) chunk(documents []Document) [][]Document {
+	batches := make([][]Document, 0, (len(documents)+p.batchSize-1)/p.batchSize)
+	for i := 0; i < len(documents); i += p.batchSize {
+		end := i + p.batchSize
+		if end > len(documents) {
+			end = len(documents)
+		}
+		batches = append(batches, documents[i:end])
+	}
+	return batches
+}
+
+func (p *Pipeline) fillErr(results []Result, offset int, n int, err error) {
+	for i := 0; i < n; i++ {
+		results[offset+i] = Result{Err: err}
+	}
+}
+
+func (p *Pipeline) runBatch(ctx context.Context, batch []Document, results []Result, offset int) {
+	for i, doc := range batch {
+		results[offset+i] = Result{Document: doc}
+	}
+
+	texts := make([]string, len(batch))
+	for i, doc := range batch {
+		texts[i] = doc.Text
+	}
+
+	vectorsPerTarget := make(map[string][][]float32, len(p.embedders))
+	for target, embedder := range p.embedders {
+		vectors, err := p.embedWithRetry(ctx, embedder, texts)
+		if err != nil {
+			p.fillErr(results, offset, len(batch), fmt.Errorf("embed target %q: %w", target, err))
+			return
+		}
+		if len(vectors) != len(texts) {
+			p.fillErr(results, offset, len(batch), fmt.Errorf(
+				"embed target %q: embedder returned %d vectors for %d documents", target, len(vectors), len(texts)))
+			return
+		}
+		vectorsPerTarget[target] = vectors
+	}
+
+	objects := make([]*models.Object, len(batch))
+	for i, doc := range batch {
+		vectors := make(models.Vectors, len(vectorsPerTarget))
+		for target, vecs := range vectorsPerTarget {
+			vectors[target] = vecs[i]
+		}
+		objects[i] = &models.Object{
+			Class:      doc.ClassName,
+			ID:         models.UUID(doc.ID),
+			Properties: doc.Properties,
+			Vectors:    vectors,
+		}
+	}
+
+	objErrs, err := p.writer.BatchCreate(ctx, objects)
+	if err != nil {
+		p.fillErr(results, offset, len(batch), fmt.Errorf("batch create: %w", err))
+		return
+	}
+	for i, objErr := range objErrs {
+		if i < len(batch) {
+			results[offset+i].Err = objErr
+		}
+	}
+}
+
+func (p *Pipeline) embedWithRetry(ctx context.Context, embedder Embedder, texts []string) ([][]float32, error) {
+	var lastErr error
+	for attempt := 0; attempt <= p.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(p.backoff(attempt - 1)):
+			}
+		}
+		vectors, err := embedder.Embed(ctx, texts)
+		if err == nil {
+			return vectors, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
@@ -0,0 +1,38 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ollama/ollama/api"
+)
+
+// OllamaEmbedder embeds texts through a local Ollama model via api.Client.Embeddings.
+// Ollama embeds one prompt per request, so a batch is embedded with one call per text.
+type OllamaEmbedder struct {
+	client *api.Client
+	model  string
+}
+
+// NewOllamaEmbedder returns an Embedder backed by the given Ollama client and model name.
+func NewOllamaEmbedder(client *api.Client, model string) *OllamaEmbedder {
+	return &OllamaEmbedder{client: client, model: model}
+}
+
+func (e *OllamaEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		resp, err := e.client.Embeddings(ctx, &api.EmbeddingRequest{
+			Model:  e.model,
+			Prompt: text,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ollama embeddings: %w", err)
+		}
+		vectors[i] = make([]float32, len(resp.Embedding))
+		for j, v := range resp.Embedding {
+			vectors[i][j] = float32(v)
+		}
+	}
+	return vectors, nil
+}
@@ -0,0 +1,74 @@
+package embedder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const openAIEmbeddingsURL = "https://api.openai.com/v1/embeddings"
+
+// OpenAIEmbedder embeds texts through the OpenAI embeddings API.
+type OpenAIEmbedder struct {
+	apiKey     string
+	model      string
+	httpClient *http.Client
+}
+
+// NewOpenAIEmbedder returns an Embedder backed by the given OpenAI API key and model
+// (e.g. "text-embedding-3-small").
+func NewOpenAIEmbedder(apiKey string, model string) *OpenAIEmbedder {
+	return &OpenAIEmbedder{apiKey: apiKey, model: model, httpClient: http.DefaultClient}
+}
+
+type openAIEmbeddingsRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type openAIEmbeddingsResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (e *OpenAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(openAIEmbeddingsRequest{Model: e.model, Input: texts})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, openAIEmbeddingsURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+e.apiKey)
+
+	resp, err := e.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("openai embeddings: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("openai embeddings: unexpected status %d", resp.StatusCode)
+	}
+
+	var parsed openAIEmbeddingsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+
+	vectors := make([][]float32, len(texts))
+	for _, d := range parsed.Data {
+		if d.Index < 0 || d.Index >= len(vectors) {
+			continue
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	return vectors, nil
+}
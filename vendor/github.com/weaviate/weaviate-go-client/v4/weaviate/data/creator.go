@@ -0,0 +1,113 @@
+package data
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Connection is the minimal REST transport a Creator needs to send the
+// assembled object to Weaviate. The concrete implementation lives on the
+// top-level client and is injected via NewCreator.
+type Connection interface {
+	RunREST(ctx context.Context, path string, method string, body interface{}) (*models.Object, error)
+}
+
+// Creator builds and sends a single object creation request
+type Creator struct {
+	connection Connection
+
+	className  string
+	id         string
+	properties models.PropertySchema
+	vector     []float32
+	vectors    map[string][][]float32
+	tenant     string
+}
+
+// NewCreator constructs a Creator that sends its request over connection
+func NewCreator(connection Connection) *Creator {
+	return &Creator{connection: connection}
+}
+
+// WithClassName specifies the class of the object to be created
+func (creator *Creator) WithClassName(className string) *Creator {
+	creator.className = className
+	return creator
+}
+
+// WithID specifies the uuid of the object to be created. If omitted the server assigns one.
+func (creator *Creator) WithID(id string) *Creator {
+	creator.id = id
+	return creator
+}
+
+// WithProperties of the object
+func (creator *Creator) WithProperties(propertySchema models.PropertySchema) *Creator {
+	creator.properties = propertySchema
+	return creator
+}
+
+// WithVector assigns the default, unnamed vector of the object
+func (creator *Creator) WithVector(vector []float32) *Creator {
+	creator.vector = vector
+	return creator
+}
+
+// WithVectors assigns one or more named target vectors to the object. Each target maps to a
+// *list* of vectors rather than a single one, so that multi-vector (ColBERT/ColPali-style
+// late-interaction) embeddings - one vector per token - can be stored for a named target vector
+// in a single create call, alongside regular single-vector targets (passed as a one-element list).
+//
+// models.Vectors' value type is a flat models.Vector ([]float32 in the weaviate version this
+// client targets), which cannot hold a [][]float32. So named multi-vector targets are sent as
+// their own JSON field on the request body (see objectPayload) rather than through
+// models.Object.Vectors.
+func (creator *Creator) WithVectors(vectors map[string][][]float32) *Creator {
+	creator.vectors = vectors
+	return creator
+}
+
+// WithTenant specifies the tenant of the object in a multi-tenancy collection
+func (creator *Creator) WithTenant(tenant string) *Creator {
+	creator.tenant = tenant
+	return creator
+}
+
+// objectPayload mirrors the wire shape of models.Object, but with a `vectors` field wide enough
+// to carry named multi-vector (ColBERT/ColPali) targets. The server accepts either a flat vector
+// or a list of vectors per named target; models.Object's typed Vectors field only allows the
+// former, so multi-vector creates are sent through this struct instead.
+type objectPayload struct {
+	Class      string                 `json:"class"`
+	ID         models.UUID            `json:"id,omitempty"`
+	Properties models.PropertySchema  `json:"properties,omitempty"`
+	Vector     []float32              `json:"vector,omitempty"`
+	Vectors    map[string][][]float32 `json:"vectors,omitempty"`
+	Tenant     string                 `json:"tenant,omitempty"`
+}
+
+func (creator *Creator) payload() interface{} {
+	if len(creator.vectors) == 0 {
+		return &models.Object{
+			Class:      creator.className,
+			ID:         models.UUID(creator.id),
+			Properties: creator.properties,
+			Vector:     creator.vector,
+			Tenant:     creator.tenant,
+		}
+	}
+	return &objectPayload{
+		Class:      creator.className,
+		ID:         models.UUID(creator.id),
+		Properties: creator.properties,
+		Vector:     creator.vector,
+		Vectors:    creator.vectors,
+		Tenant:     creator.tenant,
+	}
+}
+
+// Do sends the create request to Weaviate and returns the created object
+func (creator *Creator) Do(ctx context.Context) (*models.Object, error) {
+	return creator.connection.RunREST(ctx, "/objects", "POST", creator.payload())
+}
@@ -0,0 +1,167 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// hybridNestedSearch is implemented by the near* argument builders that can be
+// nested inside a hybrid clause via WithSearches. Their target vectors are
+// configured on the hybrid clause itself, so they build without targets/targetVectors.
+type hybridNestedSearch interface {
+	buildForHybrid() (string, error)
+}
+
+type HybridArgumentBuilder struct {
+	query         string
+	vector        []float32
+	alpha         float32
+	withAlpha     bool
+	properties    []string
+	fusionType    string
+	searches      []hybridNestedSearch
+	targetVectors []string
+	targets       *MultiTargetArgumentBuilder
+}
+
+// WithQuery sets the query to be searched for
+func (b *HybridArgumentBuilder) WithQuery(query string) *HybridArgumentBuilder {
+	b.query = query
+	return b
+}
+
+// WithVector sets the search vector to combine with the keyword search
+func (b *HybridArgumentBuilder) WithVector(vector []float32) *HybridArgumentBuilder {
+	b.vector = vector
+	return b
+}
+
+// WithAlpha sets the weighting between keyword (BM25) and vector search
+func (b *HybridArgumentBuilder) WithAlpha(alpha float32) *HybridArgumentBuilder {
+	b.withAlpha = true
+	b.alpha = alpha
+	return b
+}
+
+// WithProperties sets the properties to be searched for the keyword (BM25) part of the query
+func (b *HybridArgumentBuilder) WithProperties(properties []string) *HybridArgumentBuilder {
+	b.properties = properties
+	return b
+}
+
+// WithFusionType sets the algorithm used to combine the keyword and vector search results,
+// e.g. "rankedFusion" or "relativeScoreFusion"
+func (b *HybridArgumentBuilder) WithFusionType(fusionType string) *HybridArgumentBuilder {
+	b.fusionType = fusionType
+	return b
+}
+
+// WithSearches nests a NearVectorArgumentBuilder/NearTextArgumentBuilder search inside the hybrid
+// clause, e.g. to run hybrid search against a nearText query instead of a plain vector. A nested
+// search's own target vectors, if any, are lifted onto the hybrid clause at build time - see
+// resolvedTargets.
+func (b *HybridArgumentBuilder) WithSearches(searches ...hybridNestedSearch) *HybridArgumentBuilder {
+	b.searches = searches
+	return b
+}
+
+// WithTargetVectors target vector names
+func (b *HybridArgumentBuilder) WithTargetVectors(targetVectors ...string) *HybridArgumentBuilder {
+	if len(targetVectors) > 0 {
+		b.targetVectors = targetVectors
+	}
+	return b
+}
+
+// WithTargets sets the multi target vectors to be used with the hybrid query. This builder
+// method takes precedence over WithTargetVectors. So if WithTargets is used, WithTargetVectors
+// will be ignored.
+func (b *HybridArgumentBuilder) WithTargets(targets *MultiTargetArgumentBuilder) *HybridArgumentBuilder {
+	b.targets = targets
+	return b
+}
+
+// resolvedTargets reconciles target vectors set directly (WithTargets/WithTargetVectors) against
+// target vectors set on a nested search (WithSearches), regardless of call order, since the
+// server only accepts them at the top level of the hybrid clause. Returns an error if target
+// vectors were set in more than one place (even with equal values), rather than silently picking
+// one and dropping the other.
+func (b *HybridArgumentBuilder) resolvedTargets() (*MultiTargetArgumentBuilder, []string, error) {
+	targets, targetVectors := b.targets, b.targetVectors
+
+	for _, search := range b.searches {
+		var nestedTargets *MultiTargetArgumentBuilder
+		var nestedTargetVectors []string
+		switch s := search.(type) {
+		case *NearVectorArgumentBuilder:
+			nestedTargets, nestedTargetVectors = s.targets, s.targetVectors
+		case *NearTextArgumentBuilder:
+			nestedTargets, nestedTargetVectors = s.targets, s.targetVectors
+		default:
+			continue
+		}
+		if nestedTargets == nil && len(nestedTargetVectors) == 0 {
+			continue
+		}
+		if targets != nil || len(targetVectors) > 0 {
+			return nil, nil, fmt.Errorf(
+				"graphql: target vectors set in more than one place (hybrid clause or nested searches); set them in only one place")
+		}
+		targets, targetVectors = nestedTargets, nestedTargetVectors
+	}
+
+	return targets, targetVectors, nil
+}
+
+// build build the given clause
+func (b *HybridArgumentBuilder) build() (string, error) {
+	clause := []string{}
+	if b.query != "" {
+		query, _ := json.Marshal(b.query)
+		clause = append(clause, fmt.Sprintf("query: %s", query))
+	}
+	if len(b.vector) > 0 {
+		vector, err := json.Marshal(b.vector)
+		if err != nil {
+			panic(fmt.Sprintf("could not marshal vector: %v", err))
+		}
+		clause = append(clause, fmt.Sprintf("vector: %s", vector))
+	}
+	if b.withAlpha {
+		clause = append(clause, fmt.Sprintf("alpha: %v", b.alpha))
+	}
+	if len(b.properties) > 0 {
+		properties, _ := json.Marshal(b.properties)
+		clause = append(clause, fmt.Sprintf("properties: %s", properties))
+	}
+	if b.fusionType != "" {
+		clause = append(clause, fmt.Sprintf("fusionType: %s", b.fusionType))
+	}
+	for _, search := range b.searches {
+		nested, err := search.buildForHybrid()
+		if err != nil {
+			return "", err
+		}
+		clause = append(clause, nested)
+	}
+
+	// Target vectors live exclusively at the top level of the hybrid clause; reconcile whatever
+	// was set directly against whatever was configured on a nested search.
+	targets, targetVectors, err := b.resolvedTargets()
+	if err != nil {
+		return "", err
+	}
+	if targets != nil {
+		targetsClause, err := targets.build()
+		if err != nil {
+			return "", err
+		}
+		clause = append(clause, fmt.Sprintf("targets: {%s}", targetsClause))
+	} else if len(targetVectors) > 0 {
+		targetVectorsJSON, _ := json.Marshal(targetVectors)
+		clause = append(clause, fmt.Sprintf("targetVectors: %s", targetVectorsJSON))
+	}
+
+	return fmt.Sprintf("hybrid:{%v}", strings.Join(clause, " ")), nil
+}
@@ -0,0 +1,52 @@
+package graphql
+
+import "testing"
+
+func TestHybridArgumentBuilder_ResolvedTargets_ConflictSearchesThenTargets(t *testing.T) {
+	builder := (&HybridArgumentBuilder{}).
+		WithSearches(&NearVectorArgumentBuilder{targetVectors: []string{"v1"}}).
+		WithTargetVectors("v2")
+
+	if _, err := builder.build(); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestHybridArgumentBuilder_ResolvedTargets_ConflictTargetsThenSearches(t *testing.T) {
+	builder := (&HybridArgumentBuilder{}).
+		WithTargetVectors("v2").
+		WithSearches(&NearVectorArgumentBuilder{targetVectors: []string{"v1"}})
+
+	if _, err := builder.build(); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestHybridArgumentBuilder_ResolvedTargets_NestedSearchTargetsLifted(t *testing.T) {
+	builder := (&HybridArgumentBuilder{}).
+		WithSearches(&NearVectorArgumentBuilder{targetVectors: []string{"v1"}})
+
+	got, err := builder.build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `hybrid:{nearVector:{} targetVectors: ["v1"]}`
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestHybridArgumentBuilder_WithTargets_TakesPrecedenceOverTargetVectors(t *testing.T) {
+	builder := (&HybridArgumentBuilder{}).
+		WithTargetVectors("v1").
+		WithTargets((&MultiTargetArgumentBuilder{}).WithTargetVectors("v2").WithAverage())
+
+	got, err := builder.build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `hybrid:{targets: {targetVectors: ["v2"] combinationMethod: average}}`
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,109 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	combinationMethodMinimum       = "minimum"
+	combinationMethodAverage       = "average"
+	combinationMethodSum           = "sum"
+	combinationMethodManualWeights = "manualWeights"
+	combinationMethodRelativeScore = "relativeScore"
+)
+
+// MultiTargetArgumentBuilder builds the `targets` argument shared by nearVector,
+// nearText and hybrid clauses when searching over more than one target vector.
+type MultiTargetArgumentBuilder struct {
+	targetVectors     []string
+	combinationMethod string
+	weights           map[string]float32
+}
+
+// WithTargetVectors sets the target vector names to search over
+func (b *MultiTargetArgumentBuilder) WithTargetVectors(targetVectors ...string) *MultiTargetArgumentBuilder {
+	if len(targetVectors) > 0 {
+		b.targetVectors = targetVectors
+	}
+	return b
+}
+
+// WithMinimum combines per-target distances/certainties by taking the best (minimum distance) one
+func (b *MultiTargetArgumentBuilder) WithMinimum() *MultiTargetArgumentBuilder {
+	b.combinationMethod = combinationMethodMinimum
+	return b
+}
+
+// WithAverage combines per-target distances/certainties by averaging them
+func (b *MultiTargetArgumentBuilder) WithAverage() *MultiTargetArgumentBuilder {
+	b.combinationMethod = combinationMethodAverage
+	return b
+}
+
+// WithSum combines per-target distances/certainties by summing them
+func (b *MultiTargetArgumentBuilder) WithSum() *MultiTargetArgumentBuilder {
+	b.combinationMethod = combinationMethodSum
+	return b
+}
+
+// WithManualWeights combines per-target distances/certainties using the given fixed weight per target
+func (b *MultiTargetArgumentBuilder) WithManualWeights(weights map[string]float32) *MultiTargetArgumentBuilder {
+	b.combinationMethod = combinationMethodManualWeights
+	b.weights = weights
+	return b
+}
+
+// WithRelativeScore combines per-target distances/certainties by first normalizing each target's
+// scores to a common scale and then weighting them by the given per-target weight
+func (b *MultiTargetArgumentBuilder) WithRelativeScore(weights map[string]float32) *MultiTargetArgumentBuilder {
+	b.combinationMethod = combinationMethodRelativeScore
+	b.weights = weights
+	return b
+}
+
+// build build the given clause
+func (b *MultiTargetArgumentBuilder) build() (string, error) {
+	if err := b.validate(); err != nil {
+		return "", err
+	}
+
+	clause := []string{}
+	if len(b.targetVectors) > 0 {
+		targetVectors, _ := json.Marshal(b.targetVectors)
+		clause = append(clause, fmt.Sprintf("targetVectors: %s", targetVectors))
+	}
+	if b.combinationMethod != "" {
+		clause = append(clause, fmt.Sprintf("combinationMethod: %s", b.combinationMethod))
+	}
+	if len(b.weights) > 0 {
+		targets := make([]string, 0, len(b.weights))
+		for target := range b.weights {
+			targets = append(targets, target)
+		}
+		sort.Strings(targets)
+
+		weights := make([]string, 0, len(targets))
+		for _, target := range targets {
+			weights = append(weights, fmt.Sprintf("%s: %v", target, b.weights[target]))
+		}
+		clause = append(clause, fmt.Sprintf("weights: {%s}", strings.Join(weights, ",")))
+	}
+	return strings.Join(clause, " "), nil
+}
+
+// validate rejects configurations the server would reject, so that callers find out before
+// sending a malformed GraphQL query.
+func (b *MultiTargetArgumentBuilder) validate() error {
+	weighted := b.combinationMethod == combinationMethodManualWeights || b.combinationMethod == combinationMethodRelativeScore
+	if len(b.weights) > 0 && !weighted {
+		return fmt.Errorf("graphql: weights were set but the combination method does not use weights; " +
+			"use WithManualWeights or WithRelativeScore instead")
+	}
+	if weighted && len(b.weights) == 0 {
+		return fmt.Errorf("graphql: WithManualWeights/WithRelativeScore requires weights")
+	}
+	return nil
+}
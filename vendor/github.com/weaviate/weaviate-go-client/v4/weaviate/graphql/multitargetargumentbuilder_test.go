@@ -0,0 +1,41 @@
+package graphql
+
+import "testing"
+
+func TestMultiTargetArgumentBuilder_Validate_WeightsWithoutWeightedMethod(t *testing.T) {
+	builder := (&MultiTargetArgumentBuilder{}).
+		WithTargetVectors("v1", "v2").
+		WithAverage()
+	builder.weights = map[string]float32{"v1": 1}
+
+	if _, err := builder.build(); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestMultiTargetArgumentBuilder_Validate_WeightedMethodWithoutWeights(t *testing.T) {
+	builder := (&MultiTargetArgumentBuilder{}).
+		WithTargetVectors("v1", "v2").
+		WithManualWeights(nil)
+
+	if _, err := builder.build(); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestMultiTargetArgumentBuilder_Build_WeightsOrderedDeterministically(t *testing.T) {
+	builder := (&MultiTargetArgumentBuilder{}).
+		WithTargetVectors("v1", "v2", "v3").
+		WithManualWeights(map[string]float32{"v3": 1, "v1": 2, "v2": 3})
+
+	want := `targetVectors: ["v1","v2","v3"] combinationMethod: manualWeights weights: {v1: 2,v2: 3,v3: 1}`
+	for i := 0; i < 10; i++ {
+		got, err := builder.build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != want {
+			t.Fatalf("expected %q, got %q", want, got)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type NearTextArgumentBuilder struct {
+	concepts      []string
+	withCertainty bool
+	certainty     float32
+	withDistance  bool
+	distance      float32
+	moveTo        *NearTextMoveParameters
+	moveAwayFrom  *NearTextMoveParameters
+	targetVectors []string
+	targets       *MultiTargetArgumentBuilder
+}
+
+// NearTextMoveParameters e.g. to move the query closer/further from certain concepts
+type NearTextMoveParameters struct {
+	Concepts []string
+	Force    float32
+}
+
+// WithConcepts sets the concepts to be searched for
+func (b *NearTextArgumentBuilder) WithConcepts(concepts []string) *NearTextArgumentBuilder {
+	b.concepts = concepts
+	return b
+}
+
+// WithCertainty that is minimally required for an object to be included in the result set
+func (b *NearTextArgumentBuilder) WithCertainty(certainty float32) *NearTextArgumentBuilder {
+	b.withCertainty = true
+	b.certainty = certainty
+	return b
+}
+
+// WithDistance that is minimally required for an object to be included in the result set
+func (b *NearTextArgumentBuilder) WithDistance(distance float32) *NearTextArgumentBuilder {
+	b.withDistance = true
+	b.distance = distance
+	return b
+}
+
+// WithMoveTo moves the results closer to the given concepts
+func (b *NearTextArgumentBuilder) WithMoveTo(parameters *NearTextMoveParameters) *NearTextArgumentBuilder {
+	b.moveTo = parameters
+	return b
+}
+
+// WithMoveAwayFrom moves the results further away from the given concepts
+func (b *NearTextArgumentBuilder) WithMoveAwayFrom(parameters *NearTextMoveParameters) *NearTextArgumentBuilder {
+	b.moveAwayFrom = parameters
+	return b
+}
+
+// WithTargetVectors target vector name
+func (b *NearTextArgumentBuilder) WithTargetVectors(targetVectors ...string) *NearTextArgumentBuilder {
+	if len(targetVectors) > 0 {
+		b.targetVectors = targetVectors
+	}
+	return b
+}
+
+// WithTargets sets the multi target vectors to be used with hybrid query. This builder method takes precedence over WithTargetVectors.
+// So if WithTargets is used, WithTargetVectors will be ignored.
+func (b *NearTextArgumentBuilder) WithTargets(targets *MultiTargetArgumentBuilder) *NearTextArgumentBuilder {
+	b.targets = targets
+	return b
+}
+
+// Build build the given clause
+func (b *NearTextArgumentBuilder) build() (string, error) {
+	parts, err := b.buildClauseParts(true)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("nearText:{%v}", strings.Join(parts, " ")), nil
+}
+
+// buildForHybrid builds the nearText clause to be nested inside a hybrid search.
+// Target vectors are configured once on the hybrid clause itself, so a nested
+// nearText must not emit its own targets/targetVectors.
+func (b *NearTextArgumentBuilder) buildForHybrid() (string, error) {
+	parts, err := b.buildClauseParts(false)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("nearText:{%v}", strings.Join(parts, " ")), nil
+}
+
+func (b *NearTextArgumentBuilder) buildClauseParts(includeTargets bool) ([]string, error) {
+	clause := []string{}
+	if len(b.concepts) > 0 {
+		concepts, _ := json.Marshal(b.concepts)
+		clause = append(clause, fmt.Sprintf("concepts: %v", string(concepts)))
+	}
+	if b.withCertainty {
+		clause = append(clause, fmt.Sprintf("certainty: %v", b.certainty))
+	}
+	if b.withDistance {
+		clause = append(clause, fmt.Sprintf("distance: %v", b.distance))
+	}
+	if b.moveTo != nil {
+		clause = append(clause, fmt.Sprintf("moveTo: %v", buildMoveParameters(b.moveTo)))
+	}
+	if b.moveAwayFrom != nil {
+		clause = append(clause, fmt.Sprintf("moveAwayFrom: %v", buildMoveParameters(b.moveAwayFrom)))
+	}
+
+	if !includeTargets {
+		return clause, nil
+	}
+
+	if b.targets != nil {
+		targets, err := b.targets.build()
+		if err != nil {
+			return nil, err
+		}
+		clause = append(clause, fmt.Sprintf("targets: {%s}", targets))
+	} else if len(b.targetVectors) > 0 {
+		targetVectors, _ := json.Marshal(b.targetVectors)
+		clause = append(clause, fmt.Sprintf("targetVectors: %s", targetVectors))
+	}
+	return clause, nil
+}
+
+func buildMoveParameters(parameters *NearTextMoveParameters) string {
+	concepts, _ := json.Marshal(parameters.Concepts)
+	return fmt.Sprintf("{concepts: %v force: %v}", string(concepts), parameters.Force)
+}
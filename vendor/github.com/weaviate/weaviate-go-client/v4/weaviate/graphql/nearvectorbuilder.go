@@ -7,14 +7,16 @@ import (
 )
 
 type NearVectorArgumentBuilder struct {
-	vector           []float32
-	vectorsPerTarget map[string][][]float32
-	withCertainty    bool
-	certainty        float32
-	withDistance     bool
-	distance         float32
-	targetVectors    []string
-	targets          *MultiTargetArgumentBuilder
+	vector               []float32
+	vectorsPerTarget     map[string][][]float32
+	multiVector          [][]float32
+	multiVectorPerTarget map[string][][][]float32
+	withCertainty        bool
+	certainty            float32
+	withDistance         bool
+	distance             float32
+	targetVectors        []string
+	targets              *MultiTargetArgumentBuilder
 }
 
 // WithVector sets the search vector to be used in query
@@ -45,6 +47,23 @@ func (b *NearVectorArgumentBuilder) WithVectorsPerTarget(vectorPerTarget map[str
 	return b
 }
 
+// WithMultiVector sets a single multi-vector (e.g. ColBERT/ColPali late-interaction) search
+// query, represented as a set of vectors rather than one. This builder method takes precedence
+// over WithVector - if WithMultiVector is used, WithVector is ignored.
+func (b *NearVectorArgumentBuilder) WithMultiVector(vectors [][]float32) *NearVectorArgumentBuilder {
+	b.multiVector = vectors
+	return b
+}
+
+// WithMultiVectorPerTarget sets a multi-vector search query per named target vector, for
+// searching multiple multi-vector (ColBERT/ColPali) targets at once. Unlike WithVectorsPerTarget,
+// which lists several independent query vectors against the same target, each [][]float32 in a
+// target's list here is the full set of vectors making up a single multi-vector query for that target.
+func (b *NearVectorArgumentBuilder) WithMultiVectorPerTarget(vectorsPerTarget map[string][][][]float32) *NearVectorArgumentBuilder {
+	b.multiVectorPerTarget = vectorsPerTarget
+	return b
+}
+
 // WithCertainty that is minimally required for an object to be included in the result set
 func (b *NearVectorArgumentBuilder) WithCertainty(certainty float32) *NearVectorArgumentBuilder {
 	b.withCertainty = true
@@ -75,7 +94,26 @@ func (b *NearVectorArgumentBuilder) WithTargets(targets *MultiTargetArgumentBuil
 }
 
 // Build build the given clause
-func (b *NearVectorArgumentBuilder) build() string {
+func (b *NearVectorArgumentBuilder) build() (string, error) {
+	parts, err := b.buildClauseParts(true)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("nearVector:{%v}", strings.Join(parts, " ")), nil
+}
+
+// buildForHybrid builds the nearVector clause to be nested inside a hybrid search.
+// Target vectors are configured once on the hybrid clause itself, so a nested
+// nearVector must not emit its own targets/targetVectors.
+func (b *NearVectorArgumentBuilder) buildForHybrid() (string, error) {
+	parts, err := b.buildClauseParts(false)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("nearVector:{%v}", strings.Join(parts, " ")), nil
+}
+
+func (b *NearVectorArgumentBuilder) buildClauseParts(includeTargets bool) ([]string, error) {
 	clause := []string{}
 	if b.withCertainty {
 		clause = append(clause, fmt.Sprintf("certainty: %v", b.certainty))
@@ -95,15 +133,41 @@ func (b *NearVectorArgumentBuilder) build() string {
 		}
 		clause = append(clause, fmt.Sprintf("vectorPerTarget: {%s}", strings.Join(vectorPerTarget, ",")))
 	}
-	if len(b.vector) != 0 && len(b.vectorsPerTarget) == 0 {
+	if len(b.multiVector) > 0 {
+		vectorB, err := json.Marshal(b.multiVector)
+		if err != nil {
+			panic(fmt.Sprintf("could not marshal multi vector: %v", err))
+		}
+		clause = append(clause, fmt.Sprintf("vector: %s", string(vectorB)))
+	} else if len(b.vector) != 0 && len(b.vectorsPerTarget) == 0 {
 		vectorB, err := json.Marshal(b.vector)
 		if err != nil {
 			panic(fmt.Errorf("failed to unmarshal nearVector search vector: %s", err))
 		}
 		clause = append(clause, fmt.Sprintf("vector: %s", string(vectorB)))
 	}
+	if len(b.multiVectorPerTarget) > 0 {
+		multiVectorPerTarget := make([]string, 0, len(b.multiVectorPerTarget))
+		for target, vectors := range b.multiVectorPerTarget {
+			vBytes, err := json.Marshal(vectors)
+			if err != nil {
+				panic(fmt.Sprintf("could not marshal multi vector for target %q: %v", target, err))
+			}
+			multiVectorPerTarget = append(multiVectorPerTarget, fmt.Sprintf("%s: %v", target, string(vBytes)))
+		}
+		clause = append(clause, fmt.Sprintf("vectors: {%s}", strings.Join(multiVectorPerTarget, ",")))
+	}
+
+	if !includeTargets {
+		return clause, nil
+	}
+
 	if b.targets != nil {
-		clause = append(clause, fmt.Sprintf("targets: {%s}", b.targets.build()))
+		targets, err := b.targets.build()
+		if err != nil {
+			return nil, err
+		}
+		clause = append(clause, fmt.Sprintf("targets: {%s}", targets))
 	}
 
 	targetVectors := b.prepareTargetVectors(b.targetVectors)
@@ -111,7 +175,7 @@ func (b *NearVectorArgumentBuilder) build() string {
 		targetVectors, _ := json.Marshal(targetVectors)
 		clause = append(clause, fmt.Sprintf("targetVectors: %s", targetVectors))
 	}
-	return fmt.Sprintf("nearVector:{%v}", strings.Join(clause, " "))
+	return clause, nil
 }
 
 // prepareTargetVectors adds appends the target name for each target vector associated with it.
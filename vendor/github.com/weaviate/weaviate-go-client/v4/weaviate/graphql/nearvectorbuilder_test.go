@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNearVectorArgumentBuilder_WithMultiVector(t *testing.T) {
+	builder := (&NearVectorArgumentBuilder{}).WithMultiVector([][]float32{{0.1, 0.2}, {0.3, 0.4}})
+
+	expected := "nearVector:{vector: [[0.1,0.2],[0.3,0.4]]}"
+	got, err := builder.build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestNearVectorArgumentBuilder_WithMultiVector_overridesWithVector(t *testing.T) {
+	builder := (&NearVectorArgumentBuilder{}).
+		WithVector([]float32{1, 2, 3}).
+		WithMultiVector([][]float32{{0.1, 0.2}})
+
+	expected := "nearVector:{vector: [[0.1,0.2]]}"
+	got, err := builder.build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestNearVectorArgumentBuilder_WithMultiVectorPerTarget(t *testing.T) {
+	builder := (&NearVectorArgumentBuilder{}).
+		WithMultiVectorPerTarget(map[string][][][]float32{
+			"colbert": {{{0.1, 0.2}, {0.3, 0.4}}},
+		})
+
+	expected := "nearVector:{vectors: {colbert: [[[0.1,0.2],[0.3,0.4]]]}}"
+	got, err := builder.build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestNearVectorArgumentBuilder_MultiVectorPerTarget_distinctFromVectorsPerTarget(t *testing.T) {
+	builder := (&NearVectorArgumentBuilder{}).
+		WithVectorsPerTarget(map[string][][]float32{"text": {{0.1, 0.2}}}).
+		WithMultiVectorPerTarget(map[string][][][]float32{"colbert": {{{0.3, 0.4}}}})
+
+	got, err := builder.build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantVectorPerTarget := "vectorPerTarget: {text: [[0.1,0.2]]}"
+	wantVectors := "vectors: {colbert: [[[0.3,0.4]]]}"
+	if !strings.Contains(got, wantVectorPerTarget) || !strings.Contains(got, wantVectors) {
+		t.Errorf("expected build() to contain both %q and %q, got %q", wantVectorPerTarget, wantVectors, got)
+	}
+}
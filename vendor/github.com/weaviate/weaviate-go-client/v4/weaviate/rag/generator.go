@@ -0,0 +1,27 @@
+package rag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Generator streams a completion for prompt, one token (or token chunk) at a time. Implementations
+// must close both returned channels once the completion ends, whether it finished normally or the
+// context was cancelled. errs carries at most one value - the error that ended the stream early,
+// if any - and is closed after tokens.
+type Generator interface {
+	Generate(ctx context.Context, prompt string) (tokens <-chan string, errs <-chan error, err error)
+}
+
+// DefaultPromptTemplate renders the retrieved documents as a numbered context block followed by
+// the user's question, the common RAG prompt shape.
+func DefaultPromptTemplate(query string, docs []RetrievedDocument) string {
+	var b strings.Builder
+	b.WriteString("Answer the question using only the context below.\n\nContext:\n")
+	for i, doc := range docs {
+		fmt.Fprintf(&b, "%d. %v\n", i+1, doc.Properties)
+	}
+	fmt.Fprintf(&b, "\nQuestion: %s\nAnswer:", query)
+	return b.String()
+}
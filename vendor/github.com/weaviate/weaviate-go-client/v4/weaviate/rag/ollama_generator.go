@@ -0,0 +1,48 @@
+package rag
+
+import (
+	"context"
+
+	"github.com/ollama/ollama/api"
+)
+
+// OllamaGenerator streams completions through Ollama's Generate API.
+type OllamaGenerator struct {
+	client *api.Client
+	model  string
+}
+
+// NewOllamaGenerator returns a Generator backed by the given Ollama client and model.
+func NewOllamaGenerator(client *api.Client, model string) *OllamaGenerator {
+	return &OllamaGenerator{client: client, model: model}
+}
+
+func (g *OllamaGenerator) Generate(ctx context.Context, prompt string) (<-chan string, <-chan error, error) {
+	tokens := make(chan string)
+	errs := make(chan error, 1)
+
+	stream := true
+	req := &api.GenerateRequest{
+		Model:  g.model,
+		Prompt: prompt,
+		Stream: &stream,
+	}
+
+	go func() {
+		defer close(errs)
+		defer close(tokens)
+		err := g.client.Generate(ctx, req, func(resp api.GenerateResponse) error {
+			select {
+			case tokens <- resp.Response:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			return nil
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return tokens, errs, nil
+}
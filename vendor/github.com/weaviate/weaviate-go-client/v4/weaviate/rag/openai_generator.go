@@ -0,0 +1,110 @@
+package rag
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const openAIChatCompletionsURL = "https://api.openai.com/v1/chat/completions"
+
+// OpenAIGenerator streams completions through OpenAI's chat completions API.
+type OpenAIGenerator struct {
+	apiKey     string
+	model      string
+	httpClient *http.Client
+}
+
+// NewOpenAIGenerator returns a Generator backed by the given OpenAI API key and model.
+func NewOpenAIGenerator(apiKey string, model string) *OpenAIGenerator {
+	return &OpenAIGenerator{apiKey: apiKey, model: model, httpClient: http.DefaultClient}
+}
+
+type openAIChatRequest struct {
+	Model    string              `json:"model"`
+	Stream   bool                `json:"stream"`
+	Messages []openAIChatMessage `json:"messages"`
+}
+
+type openAIChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+}
+
+func (g *OpenAIGenerator) Generate(ctx context.Context, prompt string) (<-chan string, <-chan error, error) {
+	body, err := json.Marshal(openAIChatRequest{
+		Model:    g.model,
+		Stream:   true,
+		Messages: []openAIChatMessage{{Role: "user", Content: prompt}},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, openAIChatCompletionsURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+g.apiKey)
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, nil, fmt.Errorf("openai chat completions: unexpected status %d", resp.StatusCode)
+	}
+
+	tokens := make(chan string)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(tokens)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data: ") {
+				continue
+			}
+			payload := strings.TrimPrefix(line, "data: ")
+			if payload == "[DONE]" {
+				return
+			}
+
+			var chunk openAIChatChunk
+			if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+				continue
+			}
+			if len(chunk.Choices) == 0 {
+				continue
+			}
+			select {
+			case tokens <- chunk.Choices[0].Delta.Content:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			errs <- fmt.Errorf("openai chat completions: read stream: %w", err)
+		}
+	}()
+
+	return tokens, errs, nil
+}
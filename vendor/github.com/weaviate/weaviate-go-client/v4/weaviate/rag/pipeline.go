@@ -0,0 +1,102 @@
+// Package rag composes NearVector retrieval from Weaviate with a pluggable LLM Generator into a
+// reusable retrieve-then-generate pipeline, so the usual one-off "embed the query, search
+// Weaviate, stuff the results into a prompt, call the model" glue code doesn't need to be
+// rewritten per project.
+package rag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate-go-client/v4/weaviate/batch/embedder"
+)
+
+// Reranker re-orders/filters the retrieved documents for query before they are rendered into the
+// prompt, e.g. with a cross-encoder.
+type Reranker func(ctx context.Context, query string, docs []RetrievedDocument) ([]RetrievedDocument, error)
+
+// PromptTemplate renders the retrieved documents and the user query into the final prompt sent
+// to the Generator.
+type PromptTemplate func(query string, docs []RetrievedDocument) string
+
+// Pipeline retrieves context for a query and generates an answer grounded in it.
+type Pipeline struct {
+	embedder       embedder.Embedder
+	retriever      *Retriever
+	generator      Generator
+	promptTemplate PromptTemplate
+	reranker       Reranker
+}
+
+// Option configures a Pipeline
+type Option func(*Pipeline)
+
+// WithReranker installs a Reranker run on the retrieved documents before prompting.
+func WithReranker(reranker Reranker) Option {
+	return func(p *Pipeline) { p.reranker = reranker }
+}
+
+// WithPromptTemplate overrides DefaultPromptTemplate.
+func WithPromptTemplate(template PromptTemplate) Option {
+	return func(p *Pipeline) { p.promptTemplate = template }
+}
+
+// NewPipeline builds a Pipeline that embeds queries with embedder, retrieves context with
+// retriever, and generates answers with generator.
+func NewPipeline(embedder embedder.Embedder, retriever *Retriever, generator Generator, opts ...Option) *Pipeline {
+	p := &Pipeline{
+		embedder:       embedder,
+		retriever:      retriever,
+		generator:      generator,
+		promptTemplate: DefaultPromptTemplate,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Answer is the outcome of running the pipeline on a single query.
+type Answer struct {
+	// Tokens streams the generated answer as it is produced.
+	Tokens <-chan string
+	// Errs carries at most one value - the error that ended generation early, if any - and is
+	// closed once Tokens is closed. Drain it after Tokens closes to tell a clean finish from a
+	// dropped stream.
+	Errs <-chan error
+	// Documents are the retrieved context documents used to ground the answer, in retrieval
+	// (or, if a Reranker is set, post-rerank) order.
+	Documents []RetrievedDocument
+}
+
+// Run embeds query, retrieves grounding context for it, and starts generating an answer.
+// The returned Answer's Tokens channel streams the answer as it is produced.
+func (p *Pipeline) Run(ctx context.Context, query string) (*Answer, error) {
+	vectors, err := p.embedder.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, fmt.Errorf("embed query: %w", err)
+	}
+	if len(vectors) == 0 {
+		return nil, fmt.Errorf("embedder returned no vector for query")
+	}
+
+	docs, err := p.retriever.Retrieve(ctx, vectors[0])
+	if err != nil {
+		return nil, fmt.Errorf("retrieve context: %w", err)
+	}
+
+	if p.reranker != nil {
+		docs, err = p.reranker(ctx, query, docs)
+		if err != nil {
+			return nil, fmt.Errorf("rerank context: %w", err)
+		}
+	}
+
+	prompt := p.promptTemplate(query, docs)
+	tokens, errs, err := p.generator.Generate(ctx, prompt)
+	if err != nil {
+		return nil, fmt.Errorf("generate answer: %w", err)
+	}
+
+	return &Answer{Tokens: tokens, Errs: errs, Documents: docs}, nil
+}
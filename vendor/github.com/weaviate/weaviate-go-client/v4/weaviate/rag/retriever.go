@@ -0,0 +1,139 @@
+package rag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Mode selects which GraphQL search clause the Retriever uses. Retrieve only ever receives the
+// embedded query vector (not the original query text), so only vector-based clauses are
+// supported; nearText is intentionally out of scope until Retriever/Pipeline also carry the raw
+// query text through, which is a bigger change than this package takes on today.
+type Mode string
+
+const (
+	ModeNearVector Mode = "nearVector"
+	ModeHybrid     Mode = "hybrid"
+)
+
+// RetrievedDocument is a single search result, with the requested properties plus whichever of
+// distance/certainty the server returned for the configured Mode.
+type RetrievedDocument struct {
+	Properties map[string]interface{}
+	Distance   *float32
+	Certainty  *float32
+}
+
+// GraphQLExecutor runs an already-assembled GraphQL query and returns its decoded `data` field.
+// The client's GraphQL().Get() builder implements this once wired up with .WithNearVector(...)
+// etc.; Retriever only needs the ability to run a finished query string.
+type GraphQLExecutor interface {
+	Raw(ctx context.Context, query string) (map[string]interface{}, error)
+}
+
+// RetrieverConfig configures what a Retriever searches and returns.
+type RetrieverConfig struct {
+	ClassName string
+	Fields    []string
+	Limit     int
+	Mode      Mode
+	// Where, if set, is inlined as the GraphQL `where` filter argument.
+	Where string
+}
+
+// Retriever runs a nearVector/hybrid search and unpacks the GraphQL response into
+// RetrievedDocuments, so callers no longer have to hand-write the map[string]interface{} casts
+// needed to pull Get.<Class>.<field> out of a raw GraphQL response.
+type Retriever struct {
+	executor GraphQLExecutor
+	config   RetrieverConfig
+}
+
+// NewRetriever returns a Retriever that runs queries through executor according to config.
+func NewRetriever(executor GraphQLExecutor, config RetrieverConfig) *Retriever {
+	return &Retriever{executor: executor, config: config}
+}
+
+// Retrieve searches for queryVector and returns the configured fields for the top matches.
+func (r *Retriever) Retrieve(ctx context.Context, queryVector []float32) ([]RetrievedDocument, error) {
+	query, err := r.buildQuery(queryVector)
+	if err != nil {
+		return nil, fmt.Errorf("build query: %w", err)
+	}
+
+	data, err := r.executor.Raw(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("run query: %w", err)
+	}
+
+	return r.parseResponse(data)
+}
+
+func (r *Retriever) buildQuery(queryVector []float32) (string, error) {
+	vector, err := json.Marshal(queryVector)
+	if err != nil {
+		return "", err
+	}
+
+	var searchClause string
+	switch r.config.Mode {
+	case ModeHybrid:
+		searchClause = fmt.Sprintf("hybrid: {vector: %s}", vector)
+	default:
+		searchClause = fmt.Sprintf("nearVector: {vector: %s}", vector)
+	}
+
+	whereClause := ""
+	if r.config.Where != "" {
+		whereClause = fmt.Sprintf("where: %s", r.config.Where)
+	}
+
+	fields := append(append([]string{}, r.config.Fields...), "_additional{distance certainty}")
+	return fmt.Sprintf(
+		`{Get{%s(limit: %d %s %s){%s}}}`,
+		r.config.ClassName, r.config.Limit, searchClause, whereClause, strings.Join(fields, " "),
+	), nil
+}
+
+func (r *Retriever) parseResponse(data map[string]interface{}) ([]RetrievedDocument, error) {
+	get, ok := data["Get"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("response has no Get field")
+	}
+	raw, ok := get[r.config.ClassName].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("response has no Get.%s field", r.config.ClassName)
+	}
+
+	docs := make([]RetrievedDocument, 0, len(raw))
+	for _, entry := range raw {
+		obj, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		doc := RetrievedDocument{Properties: map[string]interface{}{}}
+		for k, v := range obj {
+			if k == "_additional" {
+				continue
+			}
+			doc.Properties[k] = v
+		}
+
+		if additional, ok := obj["_additional"].(map[string]interface{}); ok {
+			if distance, ok := additional["distance"].(float64); ok {
+				d := float32(distance)
+				doc.Distance = &d
+			}
+			if certainty, ok := additional["certainty"].(float64); ok {
+				c := float32(certainty)
+				doc.Certainty = &c
+			}
+		}
+
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}